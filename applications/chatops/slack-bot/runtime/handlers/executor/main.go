@@ -1,20 +1,46 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/open-policy-agent/opa/rego"
 )
 
 // ============================================================================
@@ -50,10 +76,369 @@ type OperationResult struct {
 	Timestamp string                 `json:"timestamp"`
 }
 
+// ============================================================================
+// OpenTelemetry Instrumentation
+// ============================================================================
+
+var (
+	tracer = otel.Tracer("slack-bot/executor")
+	meter  = otel.Meter("slack-bot/executor")
+
+	otelPropagator = propagation.TraceContext{}
+)
+
+var (
+	operationLatency metric.Float64Histogram
+	ssmCacheHits     metric.Int64Counter
+	ssmCacheMisses   metric.Int64Counter
+	providerErrors   metric.Int64Counter
+)
+
+// initMetricInstruments (re)creates the package's metric instruments against
+// the current global meter. It must run after otel.SetMeterProvider, since
+// instruments created against the default no-op provider never observe a
+// later provider swap. Called once for the no-op provider at startup and
+// again from initTelemetry once the OTLP MeterProvider is installed.
+func initMetricInstruments() {
+	var err error
+	if operationLatency, err = meter.Float64Histogram("intent.operation.duration", metric.WithUnit("ms")); err != nil {
+		logError(fmt.Sprintf("Failed to create operation latency histogram: %v", err))
+	}
+	if ssmCacheHits, err = meter.Int64Counter("secret.cache.hits"); err != nil {
+		logError(fmt.Sprintf("Failed to create secret cache hit counter: %v", err))
+	}
+	if ssmCacheMisses, err = meter.Int64Counter("secret.cache.misses"); err != nil {
+		logError(fmt.Sprintf("Failed to create secret cache miss counter: %v", err))
+	}
+	if providerErrors, err = meter.Int64Counter("cloud.provider.errors"); err != nil {
+		logError(fmt.Sprintf("Failed to create provider error counter: %v", err))
+	}
+}
+
+func init() {
+	initMetricInstruments()
+}
+
+// initTelemetry wires the global TracerProvider/MeterProvider to an OTLP
+// exporter when OTEL_EXPORTER_OTLP_ENDPOINT is configured, and returns a
+// shutdown func that flushes both. If the endpoint isn't set, tracing and
+// metrics are no-ops and shutdown is a no-op too.
+func initTelemetry(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	endpoint := getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName("slack-bot-executor"),
+			semconv.DeploymentEnvironment(environment),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build telemetry resource: %w", err)
+	}
+
+	traceExporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OTLP trace exporter: %w", err)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(traceExporter),
+		sdktrace.WithResource(res),
+	)
+
+	metricExporter, err := otlpmetricgrpc.New(ctx, otlpmetricgrpc.WithEndpoint(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OTLP metric exporter: %w", err)
+	}
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+		sdkmetric.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetMeterProvider(meterProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	tracer = otel.Tracer("slack-bot/executor")
+	meter = otel.Meter("slack-bot/executor")
+	initMetricInstruments()
+
+	return func(shutdownCtx context.Context) error {
+		traceErr := tracerProvider.Shutdown(shutdownCtx)
+		metricErr := meterProvider.Shutdown(shutdownCtx)
+		if traceErr != nil {
+			return fmt.Errorf("failed to shut down tracer provider: %w", traceErr)
+		}
+		if metricErr != nil {
+			return fmt.Errorf("failed to shut down meter provider: %w", metricErr)
+		}
+		return nil
+	}, nil
+}
+
+// extractTraceContext continues an upstream W3C trace by reading
+// "traceparent" from the CloudEvent attributes or SQS message attributes,
+// falling back to starting a fresh trace if neither is present.
+func extractTraceContext(ctx context.Context, record events.SQSMessage) context.Context {
+	carrier := propagation.MapCarrier{}
+
+	if traceparent, ok := ceAttribute(record, "traceparent"); ok {
+		carrier["traceparent"] = traceparent
+	} else if attr, found := record.MessageAttributes["traceparent"]; found && attr.StringValue != nil {
+		carrier["traceparent"] = *attr.StringValue
+	}
+
+	if len(carrier) == 0 {
+		return ctx
+	}
+
+	return otelPropagator.Extract(ctx, carrier)
+}
+
+// ============================================================================
+// CloudEvents Support
+// ============================================================================
+
+const (
+	ceSpecVersion  = "1.0"
+	ceResultType   = "io.cloudapps.result.v1"
+	ceIntentSource = "io.cloudapps.slack-bot"
+)
+
+// CloudEvent is a minimal CloudEvents 1.0 envelope covering the attributes
+// this module reads or writes. It intentionally doesn't model optional
+// extension attributes we don't use.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// decodeCloudEvent extracts a CloudEvent from an SQS record, supporting both
+// CloudEvents content modes:
+//   - structured mode: the SQS body is the JSON-encoded CloudEvent itself
+//   - binary mode: CloudEvents attributes are carried as SQS MessageAttributes
+//     with a "ce-" prefix (ce-specversion, ce-id, ce-source, ce-type, ...) and
+//     the body is the raw event data
+//
+// If the body doesn't look like a CloudEvent (no specversion) and there are
+// no ce- attributes, ok is false so callers can fall back to the legacy
+// bespoke Intent schema.
+func decodeCloudEvent(record events.SQSMessage) (ce CloudEvent, ok bool) {
+	if specVersion, found := ceAttribute(record, "specversion"); found {
+		ce.SpecVersion = specVersion
+		ce.ID, _ = ceAttribute(record, "id")
+		ce.Source, _ = ceAttribute(record, "source")
+		ce.Type, _ = ceAttribute(record, "type")
+		ce.DataContentType, _ = ceAttribute(record, "datacontenttype")
+		ce.Data = json.RawMessage(record.Body)
+		return ce, true
+	}
+
+	var structured CloudEvent
+	if err := json.Unmarshal([]byte(record.Body), &structured); err == nil && structured.SpecVersion != "" {
+		return structured, true
+	}
+
+	return CloudEvent{}, false
+}
+
+// ceAttribute reads a "ce-<name>" SQS message attribute as a string.
+func ceAttribute(record events.SQSMessage, name string) (string, bool) {
+	attr, found := record.MessageAttributes["ce-"+name]
+	if !found || attr.StringValue == nil {
+		return "", false
+	}
+	return *attr.StringValue, true
+}
+
+// intentFromCloudEvent maps a CloudEvent's data payload onto an Intent.
+func intentFromCloudEvent(ce CloudEvent) (Intent, error) {
+	var intent Intent
+	if err := json.Unmarshal(ce.Data, &intent); err != nil {
+		return Intent{}, fmt.Errorf("failed to map CloudEvent data to intent: %w", err)
+	}
+	return intent, nil
+}
+
+// resultCloudEvent wraps an OperationResult as a CloudEvents result event.
+func resultCloudEvent(intent Intent, result *OperationResult) (CloudEvent, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return CloudEvent{}, fmt.Errorf("failed to marshal result data: %w", err)
+	}
+
+	return CloudEvent{
+		SpecVersion:     ceSpecVersion,
+		ID:              intent.ID,
+		Source:          ceIntentSource,
+		Type:            ceResultType,
+		DataContentType: "application/json",
+		Data:            data,
+	}, nil
+}
+
+// sendResultCloudEvent delivers a result CloudEvent to callbackURL, using the
+// same content mode the inbound intent arrived in so producers and consumers
+// stay symmetric.
+func sendResultCloudEvent(ctx context.Context, callbackURL string, ce CloudEvent, binaryMode bool) error {
+	var req *http.Request
+	var err error
+
+	if binaryMode {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(ce.Data))
+		if err != nil {
+			return fmt.Errorf("failed to build callback request: %w", err)
+		}
+		req.Header.Set("ce-specversion", ce.SpecVersion)
+		req.Header.Set("ce-id", ce.ID)
+		req.Header.Set("ce-source", ce.Source)
+		req.Header.Set("ce-type", ce.Type)
+		if ce.DataContentType != "" {
+			req.Header.Set("Content-Type", ce.DataContentType)
+		}
+	} else {
+		body, marshalErr := json.Marshal(ce)
+		if marshalErr != nil {
+			return fmt.Errorf("failed to marshal result CloudEvent: %w", marshalErr)
+		}
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to build callback request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/cloudevents+json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver result CloudEvent: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("callback %s responded with status %d", callbackURL, resp.StatusCode)
+	}
+
+	return nil
+}
+
 // ============================================================================
 // Secret Management - RUNTIME RETRIEVAL PATTERN (Go)
 // ============================================================================
 
+// errSecretNotFound is returned (wrapped) by a SecretProvider when a key
+// doesn't exist, so GetSecret can distinguish "not found" from a transient
+// backend error and cache the two differently.
+var errSecretNotFound = errors.New("secret not found")
+
+// SecretProvider fetches a single secret value by key. Backends are
+// selected by the URI scheme on the secret's name (ssm://, asm://, gcpsm://,
+// akv://, vault://); a bare name with no scheme defaults to ssm.
+type SecretProvider interface {
+	Fetch(ctx context.Context, key string) (value string, version string, err error)
+}
+
+const (
+	secretSchemeSSM              = "ssm"
+	secretSchemeSecretsManager   = "asm"
+	secretSchemeGCPSecretManager = "gcpsm"
+	secretSchemeKeyVault         = "akv"
+	secretSchemeVault            = "vault"
+)
+
+// secretProviders maps a URI scheme to the backend that serves it. SSM
+// remains the default, fully-implemented provider; the others are
+// registered so callers can opt in by prefixing a secret name, but require
+// their respective SDKs to be wired up before they'll work.
+var secretProviders = map[string]SecretProvider{
+	secretSchemeSSM:              &ssmSecretProvider{},
+	secretSchemeSecretsManager:   &secretsManagerProvider{},
+	secretSchemeGCPSecretManager: &gcpSecretManagerProvider{},
+	secretSchemeKeyVault:         &keyVaultProvider{},
+	secretSchemeVault:            &vaultProvider{},
+}
+
+// parseSecretKey splits "scheme://key" into its parts, defaulting to ssm
+// when no scheme is present so existing hardcoded parameter paths keep
+// working unchanged.
+func parseSecretKey(raw string) (scheme, key string) {
+	if idx := strings.Index(raw, "://"); idx != -1 {
+		return raw[:idx], raw[idx+len("://"):]
+	}
+	return secretSchemeSSM, raw
+}
+
+// ssmSecretProvider is the default backend, fetching from Parameter Store.
+type ssmSecretProvider struct{}
+
+func (p *ssmSecretProvider) Fetch(ctx context.Context, key string) (string, string, error) {
+	input := &ssm.GetParameterInput{
+		Name:           aws.String(key),
+		WithDecryption: aws.Bool(true), // CRITICAL: Enable decryption
+	}
+
+	result, err := ssmClient.GetParameterWithContext(ctx, input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+			return "", "", fmt.Errorf("%w: %s", errSecretNotFound, key)
+		}
+		return "", "", err
+	}
+
+	if result.Parameter == nil || result.Parameter.Value == nil {
+		return "", "", fmt.Errorf("%w: %s", errSecretNotFound, key)
+	}
+
+	version := ""
+	if result.Parameter.Version != nil {
+		version = fmt.Sprintf("%d", *result.Parameter.Version)
+	}
+
+	return *result.Parameter.Value, version, nil
+}
+
+// secretsManagerProvider backs asm:// keys with AWS Secrets Manager.
+type secretsManagerProvider struct{}
+
+func (p *secretsManagerProvider) Fetch(ctx context.Context, key string) (string, string, error) {
+	return "", "", fmt.Errorf("asm:// secret provider not configured in this environment: %s", key)
+}
+
+// gcpSecretManagerProvider backs gcpsm:// keys with GCP Secret Manager.
+type gcpSecretManagerProvider struct{}
+
+func (p *gcpSecretManagerProvider) Fetch(ctx context.Context, key string) (string, string, error) {
+	return "", "", fmt.Errorf("gcpsm:// secret provider not configured in this environment: %s", key)
+}
+
+// keyVaultProvider backs akv:// keys with Azure Key Vault.
+type keyVaultProvider struct{}
+
+func (p *keyVaultProvider) Fetch(ctx context.Context, key string) (string, string, error) {
+	return "", "", fmt.Errorf("akv:// secret provider not configured in this environment: %s", key)
+}
+
+// vaultProvider backs vault:// keys with HashiCorp Vault.
+type vaultProvider struct{}
+
+func (p *vaultProvider) Fetch(ctx context.Context, key string) (string, string, error) {
+	return "", "", fmt.Errorf("vault:// secret provider not configured in this environment: %s", key)
+}
+
+// secretRotationFunc is invoked when a cached secret's value changes on
+// background rotation, so subscribers (e.g. the executor cache) can
+// invalidate anything built from the old value.
+type secretRotationFunc func(key, oldValue, newValue string)
+
+// rotationRefreshWindow controls how long before expiry a cached secret is
+// eagerly re-fetched by the rotation goroutine.
+const rotationRefreshWindow = 30 * time.Second
+
 // SecretCache provides thread-safe caching of secrets with expiration
 //
 // WHY CACHE?
@@ -65,31 +450,57 @@ type OperationResult struct {
 // - Allows secret rotation
 // - Balances performance and security
 type SecretCache struct {
-	cache map[string]*cachedSecret
-	mutex sync.RWMutex
-	ttl   time.Duration
+	cache        map[string]*cachedSecret
+	mutex        sync.RWMutex
+	ttl          time.Duration
+	negativeTTL  time.Duration
+	ttlOverrides map[string]time.Duration
+	onRotate     secretRotationFunc
 }
 
 type cachedSecret struct {
 	value     string
+	version   string
 	expiresAt time.Time
+	negative  bool
 }
 
-// NewSecretCache creates a new secret cache with the given TTL
+// NewSecretCache creates a new secret cache with the given default TTL.
+// Not-found lookups are cached for a much shorter, fixed negative TTL so a
+// typo'd parameter name doesn't hammer the backend on every intent.
 func NewSecretCache(ttl time.Duration) *SecretCache {
 	return &SecretCache{
-		cache: make(map[string]*cachedSecret),
-		ttl:   ttl,
+		cache:        make(map[string]*cachedSecret),
+		ttl:          ttl,
+		negativeTTL:  30 * time.Second,
+		ttlOverrides: make(map[string]time.Duration),
+	}
+}
+
+// SetTTLOverride gives a specific key its own TTL instead of the cache
+// default, e.g. a frequently-rotated credential that should be re-fetched
+// more aggressively.
+func (sc *SecretCache) SetTTLOverride(key string, ttl time.Duration) {
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+	sc.ttlOverrides[key] = ttl
+}
+
+func (sc *SecretCache) ttlFor(key string) time.Duration {
+	if ttl, ok := sc.ttlOverrides[key]; ok {
+		return ttl
 	}
+	return sc.ttl
 }
 
-// Get retrieves a secret from cache if not expired
+// Get retrieves a secret from cache if present, not expired, and not a
+// cached "not found" result.
 func (sc *SecretCache) Get(key string) (string, bool) {
 	sc.mutex.RLock()
 	defer sc.mutex.RUnlock()
 
 	cached, exists := sc.cache[key]
-	if !exists {
+	if !exists || cached.negative {
 		return "", false
 	}
 
@@ -101,71 +512,239 @@ func (sc *SecretCache) Get(key string) (string, bool) {
 	return cached.value, true
 }
 
-// Set stores a secret in cache with expiration
+// isNegativelyCached reports whether key is currently cached as not-found.
+func (sc *SecretCache) isNegativelyCached(key string) bool {
+	sc.mutex.RLock()
+	defer sc.mutex.RUnlock()
+
+	cached, exists := sc.cache[key]
+	return exists && cached.negative && time.Now().Before(cached.expiresAt)
+}
+
+// Set stores a secret in cache with expiration (kept for backward
+// compatibility; prefer setPositive internally).
 func (sc *SecretCache) Set(key, value string) {
+	sc.setPositive(key, value, "")
+}
+
+func (sc *SecretCache) setPositive(key, value, version string) {
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+	sc.evictExpiredLocked()
+
+	sc.cache[key] = &cachedSecret{
+		value:     value,
+		version:   version,
+		expiresAt: time.Now().Add(sc.ttlFor(key)),
+	}
+}
+
+func (sc *SecretCache) setNegative(key string) {
 	sc.mutex.Lock()
 	defer sc.mutex.Unlock()
+	sc.evictExpiredLocked()
+
+	sc.cache[key] = &cachedSecret{
+		negative:  true,
+		expiresAt: time.Now().Add(sc.negativeTTL),
+	}
+}
 
-	// Clean up expired entries while we have the write lock
+// evictExpiredLocked cleans up expired entries; callers must hold the
+// write lock.
+func (sc *SecretCache) evictExpiredLocked() {
 	now := time.Now()
 	for k, cached := range sc.cache {
 		if now.After(cached.expiresAt) {
 			delete(sc.cache, k)
 		}
 	}
+}
 
-	sc.cache[key] = &cachedSecret{
-		value:     value,
-		expiresAt: now.Add(sc.ttl),
+// StartRotation launches a background goroutine that periodically
+// re-fetches cached secrets nearing expiry and invokes onRotate for any
+// whose value changed, so dependents can invalidate what they built from
+// the old value. It stops when ctx is cancelled.
+func (sc *SecretCache) StartRotation(ctx context.Context, interval time.Duration, onRotate secretRotationFunc) {
+	sc.mutex.Lock()
+	sc.onRotate = onRotate
+	sc.mutex.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sc.rotateNearExpiry(ctx)
+			}
+		}
+	}()
+}
+
+func (sc *SecretCache) rotateNearExpiry(ctx context.Context) {
+	type candidate struct {
+		key      string
+		oldValue string
+	}
+
+	var candidates []candidate
+	now := time.Now()
+
+	sc.mutex.RLock()
+	for key, cached := range sc.cache {
+		if cached.negative {
+			continue
+		}
+		if cached.expiresAt.Sub(now) <= rotationRefreshWindow {
+			candidates = append(candidates, candidate{key: key, oldValue: cached.value})
+		}
+	}
+	sc.mutex.RUnlock()
+
+	for _, c := range candidates {
+		scheme, key := parseSecretKey(c.key)
+		provider, err := resolveSecretProvider(scheme)
+		if err != nil {
+			continue
+		}
+
+		value, version, err := provider.Fetch(ctx, key)
+		if err != nil {
+			logError(fmt.Sprintf("Failed to rotate secret %s: %v", c.key, err))
+			continue
+		}
+
+		sc.setPositive(c.key, value, version)
+
+		if value != c.oldValue {
+			logInfo(fmt.Sprintf("Secret rotated: %s", c.key))
+			sc.mutex.RLock()
+			onRotate := sc.onRotate
+			sc.mutex.RUnlock()
+			if onRotate != nil {
+				onRotate(c.key, c.oldValue, value)
+			}
+		}
 	}
 }
 
 // Global secret cache (5 minute TTL)
 var secretCache = NewSecretCache(5 * time.Minute)
 
+// awsSession is shared by every AWS SDK client this handler constructs.
+var awsSession = session.Must(session.NewSession())
+
 // SSM client
 var ssmClient *ssm.SSM
 
 func init() {
-	sess := session.Must(session.NewSession())
-	ssmClient = ssm.New(sess)
+	ssmClient = ssm.New(awsSession)
+}
+
+// init applies per-key secret TTL overrides from SECRET_TTL_OVERRIDES, a
+// comma-separated "key=duration" list (e.g.
+// "/slack-bot/prod/azure-credentials=30s,/slack-bot/prod/gcp-credentials=1m")
+// for secrets that should be re-fetched on a different cadence than the
+// cache default, such as frequently-rotated credentials.
+func init() {
+	raw := getEnv("SECRET_TTL_OVERRIDES", "")
+	if raw == "" {
+		return
+	}
+
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(entry, "=")
+		if !found {
+			logError(fmt.Sprintf("Invalid SECRET_TTL_OVERRIDES entry (expected key=duration): %q", entry))
+			continue
+		}
+
+		ttl, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			logError(fmt.Sprintf("Invalid TTL duration in SECRET_TTL_OVERRIDES for %q: %v", key, err))
+			continue
+		}
+
+		secretCache.SetTTLOverride(strings.TrimSpace(key), ttl)
+	}
+}
+
+func init() {
+	// Rotated GCP/Azure credentials invalidate their cached executor so the
+	// next GetExecutor call rebuilds it with fresh credentials, instead of
+	// waiting for a cold start.
+	secretCache.StartRotation(context.Background(), 1*time.Minute, func(key, oldValue, newValue string) {
+		switch {
+		case strings.HasSuffix(key, "/gcp-credentials"):
+			invalidateExecutor("gcp")
+		case strings.HasSuffix(key, "/azure-credentials"):
+			invalidateExecutor("azure")
+		}
+	})
+}
+
+func resolveSecretProvider(scheme string) (SecretProvider, error) {
+	provider, ok := secretProviders[scheme]
+	if !ok {
+		return nil, fmt.Errorf("unknown secret provider scheme: %s", scheme)
+	}
+	return provider, nil
 }
 
-// GetSecret fetches a secret from Parameter Store with caching
+// GetSecret fetches a secret from its backend (SSM by default) with
+// caching.
 //
 // SECURITY PATTERN:
 // ✅ Parameter path is hardcoded in code
 // ✅ No environment variables with paths
 // ✅ IAM policy restricts access to /slack-bot/{environment}/*
 // ✅ Encryption enabled (WithDecryption: true)
-func GetSecret(parameterName string) (string, error) {
+func GetSecret(ctx context.Context, parameterName string) (string, error) {
+	ctx, span := tracer.Start(ctx, "secret.get")
+	defer span.End()
+
 	// Check cache first
 	if value, found := secretCache.Get(parameterName); found {
+		ssmCacheHits.Add(ctx, 1)
 		return value, nil
 	}
+	ssmCacheMisses.Add(ctx, 1)
 
-	logInfo(fmt.Sprintf("Fetching secret from Parameter Store: %s", parameterName))
+	if secretCache.isNegativelyCached(parameterName) {
+		return "", fmt.Errorf("secret not found: %s", parameterName)
+	}
 
-	input := &ssm.GetParameterInput{
-		Name:           aws.String(parameterName),
-		WithDecryption: aws.Bool(true), // CRITICAL: Enable decryption
+	scheme, key := parseSecretKey(parameterName)
+	span.SetAttributes(attribute.String("secret.scheme", scheme))
+	provider, err := resolveSecretProvider(scheme)
+	if err != nil {
+		return "", err
 	}
 
-	result, err := ssmClient.GetParameter(input)
+	logInfo(fmt.Sprintf("Fetching secret from %s provider: %s", scheme, key))
+
+	value, version, err := provider.Fetch(ctx, key)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if errors.Is(err, errSecretNotFound) {
+			secretCache.setNegative(parameterName)
+		}
 		// ✅ GOOD: Error doesn't leak secret value
 		logError(fmt.Sprintf("Failed to fetch secret %s: %v", parameterName, err))
 		return "", fmt.Errorf("failed to retrieve secret: %s", parameterName)
 	}
 
-	if result.Parameter == nil || result.Parameter.Value == nil {
-		return "", fmt.Errorf("parameter %s not found or empty", parameterName)
-	}
-
-	value := *result.Parameter.Value
-
-	// Cache the secret
-	secretCache.Set(parameterName, value)
+	secretCache.setPositive(parameterName, value, version)
 
 	return value, nil
 }
@@ -175,17 +754,17 @@ func GetSecret(parameterName string) (string, error) {
 // SECURITY:
 // - Path is HARDCODED, not from environment
 // - Environment variable only selects which path to use
-func GetGCPCredentials() (string, error) {
+func GetGCPCredentials(ctx context.Context) (string, error) {
 	// ✅ GOOD: Hardcoded path with environment selector
 	parameterPath := fmt.Sprintf("/slack-bot/%s/gcp-credentials", environment)
-	return GetSecret(parameterPath)
+	return GetSecret(ctx, parameterPath)
 }
 
 // GetAzureCredentials retrieves Azure credentials
-func GetAzureCredentials() (string, error) {
+func GetAzureCredentials(ctx context.Context) (string, error) {
 	// ✅ GOOD: Hardcoded path
 	parameterPath := fmt.Sprintf("/slack-bot/%s/azure-credentials", environment)
-	return GetSecret(parameterPath)
+	return GetSecret(ctx, parameterPath)
 }
 
 // ============================================================================
@@ -230,6 +809,13 @@ func NewAWSExecutor() *AWSExecutor {
 
 // Execute runs an operation on AWS
 func (e *AWSExecutor) Execute(ctx context.Context, intent Intent) (*OperationResult, error) {
+	ctx, span := tracer.Start(ctx, "aws.execute", trace.WithAttributes(
+		attribute.String("cloud.provider", "aws"),
+		attribute.String("intent.operation", intent.Operation),
+		attribute.String("intent.id", intent.ID),
+	))
+	defer span.End()
+
 	logInfo(fmt.Sprintf("Executing AWS operation: %s", intent.Operation))
 
 	// Example operations
@@ -309,9 +895,9 @@ type GCPExecutor struct {
 }
 
 // NewGCPExecutor creates a new GCP executor
-func NewGCPExecutor() (*GCPExecutor, error) {
+func NewGCPExecutor(ctx context.Context) (*GCPExecutor, error) {
 	// ✅ SECURITY: Fetch credentials at runtime
-	credentials, err := GetGCPCredentials()
+	credentials, err := GetGCPCredentials(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve GCP credentials: %w", err)
 	}
@@ -323,6 +909,13 @@ func NewGCPExecutor() (*GCPExecutor, error) {
 
 // Execute runs an operation on GCP
 func (e *GCPExecutor) Execute(ctx context.Context, intent Intent) (*OperationResult, error) {
+	ctx, span := tracer.Start(ctx, "gcp.execute", trace.WithAttributes(
+		attribute.String("cloud.provider", "gcp"),
+		attribute.String("intent.operation", intent.Operation),
+		attribute.String("intent.id", intent.ID),
+	))
+	defer span.End()
+
 	logInfo(fmt.Sprintf("Executing GCP operation: %s", intent.Operation))
 
 	// Parse credentials (in production, use proper JSON parsing)
@@ -399,86 +992,363 @@ func (e *GCPExecutor) createVM(ctx context.Context, params map[string]interface{
 // Azure Adapter
 // ============================================================================
 
-// AzureExecutor implements CloudExecutor for Microsoft Azure
-type AzureExecutor struct {
-	credentials string
+// azureToken is a bearer token with its expiry, as returned by any
+// azureCredentialProvider.
+type azureToken struct {
+	value     string
+	expiresOn time.Time
 }
 
-// NewAzureExecutor creates a new Azure executor
-func NewAzureExecutor() (*AzureExecutor, error) {
-	// ✅ SECURITY: Fetch credentials at runtime
-	credentials, err := GetAzureCredentials()
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve Azure credentials: %w", err)
-	}
+// azureCredentialProvider mirrors the azidentity credential chain pattern:
+// each implementation knows how to obtain one kind of Azure AD token.
+// NewAzureExecutor tries them in DefaultAzureCredential's preferred order.
+type azureCredentialProvider interface {
+	// Name identifies the provider for logging/diagnostics.
+	Name() string
+	// Token returns a bearer token for the Azure Resource Manager scope.
+	Token(ctx context.Context) (azureToken, error)
+}
 
-	return &AzureExecutor{
-		credentials: credentials,
-	}, nil
+// workloadIdentityCredentialProvider exchanges the Lambda execution role's
+// STS web identity token for an Azure AD token, via an Azure AD application
+// configured for workload identity federation. This is the preferred path:
+// it requires no long-lived secret in Parameter Store.
+type workloadIdentityCredentialProvider struct {
+	tenantID string
+	clientID string
 }
 
-// Execute runs an operation on Azure
-func (e *AzureExecutor) Execute(ctx context.Context, intent Intent) (*OperationResult, error) {
-	logInfo(fmt.Sprintf("Executing Azure operation: %s", intent.Operation))
+func (p *workloadIdentityCredentialProvider) Name() string { return "workload-identity-federation" }
 
-	switch intent.Operation {
-	case "list-instances":
-		return e.listInstances(ctx, intent.Parameters)
-	case "create-vm":
-		return e.createVM(ctx, intent.Parameters)
-	default:
-		return &OperationResult{
-			Status:    "error",
-			Message:   "Unknown operation",
-			Error:     fmt.Sprintf("Operation not supported: %s", intent.Operation),
-			Timestamp: time.Now().UTC().Format(time.RFC3339),
-		}, nil
+func (p *workloadIdentityCredentialProvider) Token(ctx context.Context) (azureToken, error) {
+	if p.tenantID == "" || p.clientID == "" {
+		return azureToken{}, fmt.Errorf("workload identity federation not configured: missing tenant/client ID")
 	}
-}
-
-// ValidateAccess checks if we have valid Azure credentials
-func (e *AzureExecutor) ValidateAccess(ctx context.Context) error {
-	return nil
-}
 
-// GetMetadata returns Azure metadata
-func (e *AzureExecutor) GetMetadata() CloudMetadata {
-	return CloudMetadata{
-		Provider: "azure",
-		Region:   "eastus",
-		Version:  "1.0.0",
+	// Read the Lambda execution role's web identity token (projected by
+	// IRSA-style federation) and present it as a client assertion to Azure
+	// AD's OAuth2 token endpoint, scoped to the ARM resource. This mirrors
+	// azidentity's WorkloadIdentityCredential.
+	tokenFile := getEnv("AWS_WEB_IDENTITY_TOKEN_FILE", "")
+	if tokenFile == "" {
+		return azureToken{}, fmt.Errorf("workload identity federation not configured: missing AWS_WEB_IDENTITY_TOKEN_FILE")
 	}
-}
 
-func (e *AzureExecutor) listInstances(ctx context.Context, params map[string]interface{}) (*OperationResult, error) {
-	logInfo("Listing Azure VMs")
+	assertion, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return azureToken{}, fmt.Errorf("failed to read web identity token: %w", err)
+	}
 
-	return &OperationResult{
-		Status:  "success",
-		Message: "Azure VMs listed successfully",
-		Data: map[string]interface{}{
-			"instances": []string{"vm-1", "vm-2"},
-			"count":     2,
-		},
-		Timestamp: time.Now().UTC().Format(time.RFC3339),
-	}, nil
+	return exchangeAADToken(ctx, p.tenantID, url.Values{
+		"client_id":             {p.clientID},
+		"scope":                 {"https://management.azure.com/.default"},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      {string(assertion)},
+		"grant_type":            {"client_credentials"},
+	})
 }
 
-func (e *AzureExecutor) createVM(ctx context.Context, params map[string]interface{}) (*OperationResult, error) {
-	vmSize := params["vm-size"]
-	if vmSize == nil {
-		vmSize = "Standard_B1s"
+// exchangeAADToken posts a client-credentials grant to Azure AD's OAuth2
+// token endpoint for tenantID and parses the resulting bearer token. The
+// caller supplies the grant-specific form fields (client secret vs. client
+// assertion); everything else about the exchange is identical.
+func exchangeAADToken(ctx context.Context, tenantID string, form url.Values) (azureToken, error) {
+	tokenURL := fmt.Sprintf("https://login.microsoftonline.com/%s/oauth2/v2.0/token", tenantID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return azureToken{}, fmt.Errorf("failed to build AAD token request: %w", err)
 	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	logInfo(fmt.Sprintf("Creating Azure VM: %v", vmSize))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return azureToken{}, fmt.Errorf("AAD token exchange failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return azureToken{}, fmt.Errorf("AAD token exchange returned %s", resp.Status)
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return azureToken{}, fmt.Errorf("failed to parse AAD token response: %w", err)
+	}
+
+	return azureToken{
+		value:     tokenResp.AccessToken,
+		expiresOn: time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// clientSecretCredentialProvider pulls a client-secret JSON blob from
+// Parameter Store, same as the original Azure adapter did. Client
+// certificate credentials are not supported: a blob without clientSecret
+// is rejected.
+type clientSecretCredentialProvider struct{}
+
+func (p *clientSecretCredentialProvider) Name() string { return "client-secret" }
+
+func (p *clientSecretCredentialProvider) Token(ctx context.Context) (azureToken, error) {
+	credentials, err := GetAzureCredentials(ctx)
+	if err != nil {
+		return azureToken{}, fmt.Errorf("failed to retrieve Azure credentials: %w", err)
+	}
+
+	var creds struct {
+		TenantID     string `json:"tenantId"`
+		ClientID     string `json:"clientId"`
+		ClientSecret string `json:"clientSecret"`
+	}
+	if err := json.Unmarshal([]byte(credentials), &creds); err != nil {
+		return azureToken{}, fmt.Errorf("failed to parse Azure credentials: %w", err)
+	}
+	if creds.TenantID == "" || creds.ClientID == "" || creds.ClientSecret == "" {
+		return azureToken{}, fmt.Errorf("Azure credentials missing tenantId/clientId/clientSecret")
+	}
+
+	// Exchange the client secret for a bearer token via AAD's OAuth2
+	// client-credentials grant, mirroring azidentity's
+	// ClientSecretCredential.
+	return exchangeAADToken(ctx, creds.TenantID, url.Values{
+		"client_id":     {creds.ClientID},
+		"client_secret": {creds.ClientSecret},
+		"scope":         {"https://management.azure.com/.default"},
+		"grant_type":    {"client_credentials"},
+	})
+}
+
+// managedIdentityCredentialProvider is the last entry in the chain, named
+// after azidentity's ManagedIdentityCredential. It does not query a managed
+// identity endpoint: Token always returns an error.
+type managedIdentityCredentialProvider struct{}
+
+func (p *managedIdentityCredentialProvider) Name() string { return "managed-identity" }
+
+func (p *managedIdentityCredentialProvider) Token(ctx context.Context) (azureToken, error) {
+	return azureToken{}, fmt.Errorf("managed identity endpoint not available")
+}
+
+// AzureExecutor implements CloudExecutor for Microsoft Azure
+type AzureExecutor struct {
+	credentialProvider azureCredentialProvider
+
+	mutex       sync.Mutex
+	cachedToken azureToken
+}
+
+// NewAzureExecutor creates a new Azure executor, selecting a credential
+// provider the same way azidentity's DefaultAzureCredential does: try
+// workload identity federation first, fall back to the SSM-backed
+// client-secret path, then managed identity.
+func NewAzureExecutor(ctx context.Context) (*AzureExecutor, error) {
+	providers := []azureCredentialProvider{
+		&workloadIdentityCredentialProvider{
+			tenantID: getEnv("AZURE_TENANT_ID", ""),
+			clientID: getEnv("AZURE_CLIENT_ID", ""),
+		},
+		&clientSecretCredentialProvider{},
+		&managedIdentityCredentialProvider{},
+	}
+
+	e := &AzureExecutor{}
+	for _, provider := range providers {
+		token, err := provider.Token(ctx)
+		if err != nil {
+			logDebug(fmt.Sprintf("Azure credential provider %s unavailable: %v", provider.Name(), err))
+			continue
+		}
+		logInfo(fmt.Sprintf("Using Azure credential provider: %s", provider.Name()))
+		e.credentialProvider = provider
+		e.cachedToken = token
+		return e, nil
+	}
+
+	return nil, fmt.Errorf("no Azure credential provider could obtain a token")
+}
+
+// token returns a cached bearer token, refreshing it from the active
+// credential provider once it has expired.
+func (e *AzureExecutor) token(ctx context.Context) (string, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if time.Now().Before(e.cachedToken.expiresOn) {
+		return e.cachedToken.value, nil
+	}
+
+	token, err := e.credentialProvider.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh Azure token: %w", err)
+	}
+
+	e.cachedToken = token
+	return token.value, nil
+}
+
+// Execute runs an operation on Azure
+func (e *AzureExecutor) Execute(ctx context.Context, intent Intent) (*OperationResult, error) {
+	ctx, span := tracer.Start(ctx, "azure.execute", trace.WithAttributes(
+		attribute.String("cloud.provider", "azure"),
+		attribute.String("intent.operation", intent.Operation),
+		attribute.String("intent.id", intent.ID),
+	))
+	defer span.End()
+
+	logInfo(fmt.Sprintf("Executing Azure operation: %s", intent.Operation))
+
+	switch intent.Operation {
+	case "list-instances":
+		return e.listInstances(ctx, intent.Parameters)
+	case "create-vm":
+		return e.createVM(ctx, intent.Parameters)
+	default:
+		return &OperationResult{
+			Status:    "error",
+			Message:   "Unknown operation",
+			Error:     fmt.Sprintf("Operation not supported: %s", intent.Operation),
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, nil
+	}
+}
+
+// ValidateAccess checks if we have a valid Azure bearer token
+func (e *AzureExecutor) ValidateAccess(ctx context.Context) error {
+	_, err := e.token(ctx)
+	return err
+}
+
+// GetMetadata returns Azure metadata
+func (e *AzureExecutor) GetMetadata() CloudMetadata {
+	return CloudMetadata{
+		Provider: "azure",
+		Region:   armRegion,
+		Version:  "1.0.0",
+	}
+}
+
+// armSubscriptionID, armResourceGroup, and armRegion scope this executor's
+// ARM REST calls to the deployment's Azure subscription.
+var (
+	armSubscriptionID = getEnv("AZURE_SUBSCRIPTION_ID", "")
+	armResourceGroup  = getEnv("AZURE_RESOURCE_GROUP", "")
+	armRegion         = getEnv("AZURE_REGION", "eastus")
+)
+
+const (
+	armBaseURL    = "https://management.azure.com"
+	armAPIVersion = "2023-07-01"
+)
+
+// armRequest issues an authenticated ARM REST call using the executor's
+// cached bearer token.
+func (e *AzureExecutor) armRequest(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	token, err := e.token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate to ARM: %w", err)
+	}
+
+	reqURL := fmt.Sprintf("%s%s?api-version=%s", armBaseURL, path, armAPIVersion)
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build ARM request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	return http.DefaultClient.Do(req)
+}
+
+func (e *AzureExecutor) listInstances(ctx context.Context, params map[string]interface{}) (*OperationResult, error) {
+	logInfo("Listing Azure VMs")
+
+	path := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines", armSubscriptionID, armResourceGroup)
+
+	resp, err := e.armRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ARM list VMs request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ARM list VMs returned %s", resp.Status)
+	}
+
+	var listResp struct {
+		Value []struct {
+			Name string `json:"name"`
+		} `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("failed to parse ARM list VMs response: %w", err)
+	}
+
+	names := make([]string, 0, len(listResp.Value))
+	for _, vm := range listResp.Value {
+		names = append(names, vm.Name)
+	}
+
+	return &OperationResult{
+		Status:  "success",
+		Message: "Azure VMs listed successfully",
+		Data: map[string]interface{}{
+			"instances": names,
+			"count":     len(names),
+		},
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func (e *AzureExecutor) createVM(ctx context.Context, params map[string]interface{}) (*OperationResult, error) {
+	vmSize, _ := params["vm-size"].(string)
+	if vmSize == "" {
+		vmSize = "Standard_B1s"
+	}
+	vmName := fmt.Sprintf("vm-%d", time.Now().Unix())
+
+	logInfo(fmt.Sprintf("Creating Azure VM: %s (%s)", vmName, vmSize))
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"location": armRegion,
+		"properties": map[string]interface{}{
+			"hardwareProfile": map[string]interface{}{"vmSize": vmSize},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build ARM create VM request body: %w", err)
+	}
+
+	path := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s", armSubscriptionID, armResourceGroup, vmName)
+
+	resp, err := e.armRequest(ctx, http.MethodPut, path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("ARM create VM request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("ARM create VM returned %s", resp.Status)
+	}
+
+	var createResp struct {
+		Properties struct {
+			ProvisioningState string `json:"provisioningState"`
+		} `json:"properties"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&createResp); err != nil {
+		return nil, fmt.Errorf("failed to parse ARM create VM response: %w", err)
+	}
 
 	return &OperationResult{
 		Status:  "success",
 		Message: "Azure VM created successfully",
 		Data: map[string]interface{}{
-			"vm_name": "new-vm-azure",
+			"vm_name": vmName,
 			"vm_size": vmSize,
-			"state":   "Creating",
+			"state":   createResp.Properties.ProvisioningState,
 		},
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
 	}, nil
@@ -496,23 +1366,577 @@ func (e *AzureExecutor) createVM(ctx context.Context, params map[string]interfac
 // 3. Add credentials to Parameter Store
 // 4. Grant IAM permissions
 // 5. Test in isolation
-func GetExecutor(cloud string) (CloudExecutor, error) {
+
+// executorCache holds one executor instance per cloud so rotated
+// credentials don't force a full Lambda cold start: a rotation invalidates
+// just the affected entry and the next call below rebuilds it.
+var executorCache = struct {
+	mutex   sync.RWMutex
+	byCloud map[string]CloudExecutor
+}{byCloud: make(map[string]CloudExecutor)}
+
+// invalidateExecutor drops a cached executor so the next GetExecutor call
+// for that cloud rebuilds it with current credentials.
+func invalidateExecutor(cloud string) {
+	executorCache.mutex.Lock()
+	defer executorCache.mutex.Unlock()
+	delete(executorCache.byCloud, cloud)
+}
+
+// GetExecutor returns the cached executor for cloud, building and caching it
+// on first use (see the "HOW TO ADD A NEW CLOUD" notes above).
+func GetExecutor(ctx context.Context, cloud string) (CloudExecutor, error) {
+	executorCache.mutex.RLock()
+	if executor, ok := executorCache.byCloud[cloud]; ok {
+		executorCache.mutex.RUnlock()
+		return executor, nil
+	}
+	executorCache.mutex.RUnlock()
+
+	var executor CloudExecutor
+	var err error
+
 	switch cloud {
 	case "aws":
-		return NewAWSExecutor(), nil
+		executor = NewAWSExecutor()
 	case "gcp":
-		return NewGCPExecutor()
+		executor, err = NewGCPExecutor(ctx)
 	case "azure":
-		return NewAzureExecutor()
+		executor, err = NewAzureExecutor(ctx)
 	default:
 		return nil, fmt.Errorf("unknown cloud provider: %s", cloud)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	executorCache.mutex.Lock()
+	executorCache.byCloud[cloud] = executor
+	executorCache.mutex.Unlock()
+
+	return executor, nil
+}
+
+// ============================================================================
+// Idempotency & Dead-Letter Subsystem
+// ============================================================================
+
+// idempotencyDefaultTTL bounds how long a completed (or in-flight) intent
+// record is retained before DynamoDB TTL reclaims it.
+const idempotencyDefaultTTL = 24 * time.Hour
+
+// idempotencyLockStaleAfter bounds how long an "in_progress" record is
+// honored as a genuinely in-flight execution. Past this age the original
+// attempt is assumed to have crashed or timed out mid-execution, and the
+// lock is reclaimed so the intent gets retried instead of being dropped
+// for the rest of its TTL.
+const idempotencyLockStaleAfter = 5 * time.Minute
+
+// IdempotencyStore deduplicates redelivered SQS messages keyed on Intent.ID.
+type IdempotencyStore interface {
+	// Begin atomically records intentID as in-progress. If a record already
+	// exists from a prior delivery that completed or is still in flight,
+	// alreadyProcessed is true and result holds that delivery's
+	// OperationResult (nil if it never completed). A record marked failed,
+	// or left in progress longer than idempotencyLockStaleAfter, is
+	// reclaimed instead and alreadyProcessed is false.
+	Begin(ctx context.Context, intentID string) (result *OperationResult, alreadyProcessed bool, err error)
+	// Complete stores the terminal result for intentID.
+	Complete(ctx context.Context, intentID string, result *OperationResult) error
+	// Fail marks intentID as terminally failed, so a redelivered message
+	// retries the execution instead of short-circuiting against a stale
+	// in-progress lock.
+	Fail(ctx context.Context, intentID string) error
+}
+
+// dynamoDBIdempotencyStore implements IdempotencyStore on a DynamoDB table
+// keyed by "intentId", with "expiresAt" as its TTL attribute.
+type dynamoDBIdempotencyStore struct {
+	client    *dynamodb.DynamoDB
+	tableName string
+	ttl       time.Duration
+}
+
+func newDynamoDBIdempotencyStore(sess *session.Session) *dynamoDBIdempotencyStore {
+	return &dynamoDBIdempotencyStore{
+		client:    dynamodb.New(sess),
+		tableName: getEnv("IDEMPOTENCY_TABLE", fmt.Sprintf("slack-bot-%s-idempotency", environment)),
+		ttl:       idempotencyDefaultTTL,
+	}
+}
+
+func (s *dynamoDBIdempotencyStore) Begin(ctx context.Context, intentID string) (*OperationResult, bool, error) {
+	now := time.Now()
+
+	_, err := s.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName),
+		Item: map[string]*dynamodb.AttributeValue{
+			"intentId":  {S: aws.String(intentID)},
+			"status":    {S: aws.String("in_progress")},
+			"beganAt":   {N: aws.String(fmt.Sprintf("%d", now.Unix()))},
+			"expiresAt": {N: aws.String(fmt.Sprintf("%d", now.Add(s.ttl).Unix()))},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(intentId)"),
+	})
+	if err == nil {
+		return nil, false, nil
+	}
+
+	aerr, ok := err.(awserr.Error)
+	if !ok || aerr.Code() != dynamodb.ErrCodeConditionalCheckFailedException {
+		return nil, false, fmt.Errorf("failed to record idempotency key %s: %w", intentID, err)
+	}
+
+	// Already recorded by a prior delivery; inspect it before deciding
+	// whether this delivery should short-circuit.
+	existing, getErr := s.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(s.tableName),
+		Key:            map[string]*dynamodb.AttributeValue{"intentId": {S: aws.String(intentID)}},
+		ConsistentRead: aws.Bool(true),
+	})
+	if getErr != nil {
+		return nil, false, fmt.Errorf("failed to load idempotency record for %s: %w", intentID, getErr)
+	}
+	if existing.Item == nil {
+		// Raced with the record expiring/being removed between our put and
+		// this get; safe to just retry as if we'd won the put.
+		return nil, false, nil
+	}
+
+	status := ""
+	if statusAttr, ok := existing.Item["status"]; ok && statusAttr.S != nil {
+		status = *statusAttr.S
+	}
+
+	switch status {
+	case "completed":
+		return s.completedResult(existing.Item, intentID)
+	case "failed":
+		// The prior attempt ran to completion and failed terminally (and
+		// was already published to the dead-letter target by the caller
+		// that recorded it). There's no concurrent execution to defer to,
+		// so reclaim the lock unconditionally and let this delivery retry.
+		if reclaimErr := s.reclaimLocked(ctx, intentID, "#status = :expected",
+			map[string]*string{"#status": aws.String("status")},
+			map[string]*dynamodb.AttributeValue{":expected": {S: aws.String("failed")}},
+		); reclaimErr != nil {
+			if isConditionalCheckFailed(reclaimErr) {
+				return nil, true, nil
+			}
+			return nil, false, fmt.Errorf("failed to reclaim failed idempotency lock for %s: %w", intentID, reclaimErr)
+		}
+		return nil, false, nil
+	}
+
+	// Still "in_progress": either a genuinely concurrent delivery is
+	// handling this intent right now (safe to short-circuit — it will
+	// complete or fail the record itself), or the original attempt
+	// crashed/timed out mid-execution and abandoned the lock without
+	// reaching the error path that would have marked it "failed".
+	// Distinguish the two by age.
+	var beganAt time.Time
+	if beganAtAttr, ok := existing.Item["beganAt"]; ok && beganAtAttr.N != nil {
+		if seconds, err := strconv.ParseInt(*beganAtAttr.N, 10, 64); err == nil {
+			beganAt = time.Unix(seconds, 0)
+		}
+	}
+
+	if !beganAt.IsZero() && now.Sub(beganAt) < idempotencyLockStaleAfter {
+		return nil, true, nil
+	}
+
+	// Stale lock: reclaim it so this delivery actually retries the
+	// execution instead of being dropped for the rest of the TTL.
+	reclaimErr := s.reclaimLocked(ctx, intentID, "#status = :inProgress AND beganAt = :beganAt",
+		map[string]*string{"#status": aws.String("status")},
+		map[string]*dynamodb.AttributeValue{
+			":inProgress": {S: aws.String("in_progress")},
+			":beganAt":    {N: aws.String(fmt.Sprintf("%d", beganAt.Unix()))},
+		},
+	)
+	if reclaimErr != nil {
+		if isConditionalCheckFailed(reclaimErr) {
+			// Someone else reclaimed or completed it first; let them own it.
+			return nil, true, nil
+		}
+		return nil, false, fmt.Errorf("failed to reclaim stale idempotency lock for %s: %w", intentID, reclaimErr)
+	}
+
+	return nil, false, nil
+}
+
+// reclaimLocked overwrites intentID's record with a fresh "in_progress"
+// lock, conditioned on the record still matching the expected prior state
+// so a concurrent reclaim can't be clobbered.
+func (s *dynamoDBIdempotencyStore) reclaimLocked(ctx context.Context, intentID, condition string, names map[string]*string, values map[string]*dynamodb.AttributeValue) error {
+	now := time.Now()
+
+	_, err := s.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.tableName),
+		Item: map[string]*dynamodb.AttributeValue{
+			"intentId":  {S: aws.String(intentID)},
+			"status":    {S: aws.String("in_progress")},
+			"beganAt":   {N: aws.String(fmt.Sprintf("%d", now.Unix()))},
+			"expiresAt": {N: aws.String(fmt.Sprintf("%d", now.Add(s.ttl).Unix()))},
+		},
+		ConditionExpression:       aws.String(condition),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	})
+	return err
+}
+
+// isConditionalCheckFailed reports whether err is a DynamoDB conditional
+// check failure, i.e. a losing race against another writer.
+func isConditionalCheckFailed(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+}
+
+// Fail records a terminal failure for intentID so a redelivered message
+// retries the execution instead of being silently short-circuited by a
+// still-fresh "in_progress" lock. Unlike Complete, this doesn't store a
+// result — there's nothing to replay, the intent simply gets retried.
+func (s *dynamoDBIdempotencyStore) Fail(ctx context.Context, intentID string) error {
+	_, err := s.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:        aws.String(s.tableName),
+		Key:              map[string]*dynamodb.AttributeValue{"intentId": {S: aws.String(intentID)}},
+		UpdateExpression: aws.String("SET #status = :status"),
+		ExpressionAttributeNames: map[string]*string{
+			"#status": aws.String("status"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":status": {S: aws.String("failed")},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record idempotency failure for %s: %w", intentID, err)
+	}
+	return nil
+}
+
+// completedResult parses the OperationResult stored on a completed
+// idempotency record.
+func (s *dynamoDBIdempotencyStore) completedResult(item map[string]*dynamodb.AttributeValue, intentID string) (*OperationResult, bool, error) {
+	resultAttr, hasResult := item["result"]
+	if !hasResult || resultAttr.S == nil {
+		return nil, true, nil
+	}
+
+	var result OperationResult
+	if err := json.Unmarshal([]byte(*resultAttr.S), &result); err != nil {
+		return nil, true, fmt.Errorf("failed to parse cached result for %s: %w", intentID, err)
+	}
+
+	return &result, true, nil
+}
+
+func (s *dynamoDBIdempotencyStore) Complete(ctx context.Context, intentID string, result *OperationResult) error {
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result for idempotency record: %w", err)
+	}
+
+	_, err = s.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:        aws.String(s.tableName),
+		Key:              map[string]*dynamodb.AttributeValue{"intentId": {S: aws.String(intentID)}},
+		UpdateExpression: aws.String("SET #status = :status, #result = :result, expiresAt = :expiresAt"),
+		ExpressionAttributeNames: map[string]*string{
+			"#status": aws.String("status"),
+			"#result": aws.String("result"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":status":    {S: aws.String("completed")},
+			":result":    {S: aws.String(string(resultJSON))},
+			":expiresAt": {N: aws.String(fmt.Sprintf("%d", time.Now().Add(s.ttl).Unix()))},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency record for %s: %w", intentID, err)
+	}
+
+	return nil
+}
+
+var idempotencyStore IdempotencyStore
+
+func init() {
+	idempotencyStore = newDynamoDBIdempotencyStore(awsSession)
+}
+
+// deadLetterEnvelope is the structured payload delivered to the DLQ target
+// for an intent that failed terminally.
+type deadLetterEnvelope struct {
+	Intent   Intent `json:"intent"`
+	Error    string `json:"error"`
+	FailedAt string `json:"failedAt"`
+}
+
+// DeadLetterPublisher ships a failed intent to a dead-letter target for
+// offline triage and replay.
+type DeadLetterPublisher interface {
+	Publish(ctx context.Context, intent Intent, failureErr error) error
+}
+
+type sqsDeadLetterPublisher struct {
+	client   *sqs.SQS
+	queueURL string
+}
+
+func (p *sqsDeadLetterPublisher) Publish(ctx context.Context, intent Intent, failureErr error) error {
+	body, err := json.Marshal(deadLetterEnvelope{Intent: intent, Error: failureErr.Error(), FailedAt: time.Now().UTC().Format(time.RFC3339)})
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead-letter envelope: %w", err)
+	}
+
+	_, err = p.client.SendMessageWithContext(ctx, &sqs.SendMessageInput{
+		QueueUrl:    aws.String(p.queueURL),
+		MessageBody: aws.String(string(body)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish to dead-letter queue: %w", err)
+	}
+
+	return nil
+}
+
+type snsDeadLetterPublisher struct {
+	client   *sns.SNS
+	topicARN string
+}
+
+func (p *snsDeadLetterPublisher) Publish(ctx context.Context, intent Intent, failureErr error) error {
+	body, err := json.Marshal(deadLetterEnvelope{Intent: intent, Error: failureErr.Error(), FailedAt: time.Now().UTC().Format(time.RFC3339)})
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead-letter envelope: %w", err)
+	}
+
+	_, err = p.client.PublishWithContext(ctx, &sns.PublishInput{
+		TopicArn: aws.String(p.topicARN),
+		Message:  aws.String(string(body)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish to dead-letter topic: %w", err)
+	}
+
+	return nil
+}
+
+// newDeadLetterPublisher builds a publisher from the DLQ_TARGET env var: an
+// SNS topic ARN selects SNS, anything else is treated as an SQS queue URL.
+// Returns nil (disabled) if DLQ_TARGET isn't set.
+func newDeadLetterPublisher(sess *session.Session) DeadLetterPublisher {
+	target := getEnv("DLQ_TARGET", "")
+	if target == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(target, "arn:aws:sns:") {
+		return &snsDeadLetterPublisher{client: sns.New(sess), topicARN: target}
+	}
+
+	return &sqsDeadLetterPublisher{client: sqs.New(sess), queueURL: target}
+}
+
+var deadLetterPublisher = newDeadLetterPublisher(awsSession)
+
+// ============================================================================
+// Policy Engine (OPA/Rego authorization gate)
+// ============================================================================
+
+// defaultPolicyQuery is the Rego entrypoint evaluated for every intent.
+const defaultPolicyQuery = "data.slackbot.authz"
+
+// policyInput is what's evaluated against the policy bundle.
+type policyInput struct {
+	Operation   string                 `json:"operation"`
+	Cloud       string                 `json:"cloud"`
+	Parameters  map[string]interface{} `json:"parameters"`
+	RequestedBy string                 `json:"requestedBy"`
+	RequestedAt string                 `json:"requestedAt"`
+}
+
+// policyDecision is the policy's structured result. A denial can optionally
+// carry a reason; an allow can mutate parameters (e.g. force instance-type
+// into an allowed set) or require an approval token before dispatch.
+type policyDecision struct {
+	Allow            bool                   `json:"allow"`
+	Parameters       map[string]interface{} `json:"parameters,omitempty"`
+	ApprovalRequired bool                   `json:"approval_required,omitempty"`
+	ApprovalToken    string                 `json:"approval_token,omitempty"`
+	Reason           string                 `json:"reason,omitempty"`
+}
+
+// policySource loads the raw Rego module text for the authorization policy
+// from wherever it's hosted.
+type policySource interface {
+	// Load fetches the current policy module.
+	Load(ctx context.Context) (module string, err error)
+	// Key identifies this source for TTL-override and error-reporting
+	// purposes (the SSM parameter path, or the bundle URL).
+	Key() string
+}
+
+// ssmPolicySource loads the policy from Parameter Store, same as every
+// other secret this handler reads.
+type ssmPolicySource struct {
+	parameterPath string
+}
+
+func (s *ssmPolicySource) Load(ctx context.Context) (string, error) {
+	return GetSecret(ctx, s.parameterPath)
+}
+
+func (s *ssmPolicySource) Key() string { return s.parameterPath }
+
+// httpPolicySource fetches the policy module from a bundle URL over
+// HTTP(S) — e.g. an object storage URL serving the policy.rego source
+// file directly, so policy can be updated independently of a deploy
+// without going through Parameter Store.
+type httpPolicySource struct {
+	url string
+}
+
+func (s *httpPolicySource) Load(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build policy bundle request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch policy bundle %s: %w", s.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("policy bundle fetch %s returned %s", s.url, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read policy bundle %s: %w", s.url, err)
+	}
+
+	return string(body), nil
+}
+
+func (s *httpPolicySource) Key() string { return s.url }
+
+// PolicyEngine gates intents against a Rego policy bundle loaded from its
+// source (SSM by default, or an HTTP bundle URL when POLICY_BUNDLE_URL is
+// set), compiling it once and hot-reloading on the same TTL cadence as the
+// secret cache.
+type PolicyEngine struct {
+	mutex    sync.RWMutex
+	query    rego.PreparedEvalQuery
+	loaded   bool
+	loadedAt time.Time
+	source   policySource
+}
+
+func newPolicyEngine() *PolicyEngine {
+	if bundleURL := getEnv("POLICY_BUNDLE_URL", ""); bundleURL != "" {
+		return &PolicyEngine{source: &httpPolicySource{url: bundleURL}}
+	}
+	return &PolicyEngine{
+		source: &ssmPolicySource{parameterPath: fmt.Sprintf("/slack-bot/%s/policy.rego", environment)},
+	}
+}
+
+// loadLocked (re)compiles the policy bundle. Callers must hold the write lock.
+func (p *PolicyEngine) loadLocked(ctx context.Context) error {
+	module, err := p.source.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load policy bundle %s: %w", p.source.Key(), err)
+	}
+
+	query, err := rego.New(
+		rego.Query(defaultPolicyQuery),
+		rego.Module(p.source.Key(), module),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to compile policy bundle %s: %w", p.source.Key(), err)
+	}
+
+	p.query = query
+	p.loaded = true
+	p.loadedAt = time.Now()
+
+	return nil
+}
+
+// Evaluate runs intent through the compiled policy, reloading the bundle
+// first if it's never been loaded or has aged past its TTL.
+func (p *PolicyEngine) Evaluate(ctx context.Context, intent Intent) (policyDecision, error) {
+	p.mutex.Lock()
+	if !p.loaded || time.Since(p.loadedAt) > secretCache.ttlFor(p.source.Key()) {
+		if err := p.loadLocked(ctx); err != nil {
+			p.mutex.Unlock()
+			return policyDecision{}, err
+		}
+	}
+	query := p.query
+	p.mutex.Unlock()
+
+	results, err := query.Eval(ctx, rego.EvalInput(policyInput{
+		Operation:   intent.Operation,
+		Cloud:       intent.Cloud,
+		Parameters:  intent.Parameters,
+		RequestedBy: intent.RequestedBy,
+		RequestedAt: intent.RequestedAt,
+	}))
+	if err != nil {
+		return policyDecision{}, fmt.Errorf("policy evaluation failed: %w", err)
+	}
+	if len(results) == 0 || len(results[0].Expressions) == 0 {
+		return policyDecision{}, fmt.Errorf("policy %s returned no decision", p.source.Key())
+	}
+
+	decisionJSON, err := json.Marshal(results[0].Expressions[0].Value)
+	if err != nil {
+		return policyDecision{}, fmt.Errorf("failed to marshal policy decision: %w", err)
+	}
+
+	var decision policyDecision
+	if err := json.Unmarshal(decisionJSON, &decision); err != nil {
+		return policyDecision{}, fmt.Errorf("failed to parse policy decision: %w", err)
+	}
+
+	return decision, nil
+}
+
+var policyEngine = newPolicyEngine()
+
+// deniedResult builds the structured OperationResult returned when the
+// policy engine blocks an intent, including the decision path so the
+// denial can be traced back to the policy that produced it.
+func deniedResult(decision policyDecision) *OperationResult {
+	reason := decision.Reason
+	if reason == "" {
+		reason = "denied by policy"
+	}
+
+	return &OperationResult{
+		Status:    "denied",
+		Message:   "Operation denied by policy",
+		Error:     fmt.Sprintf("%s: %s", defaultPolicyQuery, reason),
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
 }
 
 // ============================================================================
 // Lambda Handler
 // ============================================================================
 
+// maxConcurrency bounds how many records Handler processes at once within
+// a single invocation.
+var maxConcurrency = getEnvInt("MAX_CONCURRENCY", 10)
+
+// defaultIntentTimeout is the per-intent execution budget when an intent
+// doesn't specify its own Parameters["timeoutSeconds"].
+const defaultIntentTimeout = 60 * time.Second
+
 // Handler processes SQS events containing intents
 //
 // FLOW:
@@ -520,54 +1944,243 @@ func GetExecutor(cloud string) (CloudExecutor, error) {
 // 2. Select appropriate cloud executor
 // 3. Execute operation
 // 4. Return result
-func Handler(ctx context.Context, sqsEvent events.SQSEvent) error {
+//
+// Records are fanned out across a bounded worker pool (MAX_CONCURRENCY)
+// instead of processed serially. Each intent gets its own context deadline,
+// derived from Parameters["timeoutSeconds"] and clamped to the Lambda
+// invocation's own deadline. Failed records are reported individually via
+// BatchItemFailures so SQS only re-drives the intents that actually
+// failed, not the whole batch; if the pool is saturated, a record is
+// reported as failed immediately rather than queued behind others.
+func Handler(ctx context.Context, sqsEvent events.SQSEvent) (events.SQSEventResponse, error) {
 	logInfo(fmt.Sprintf("Processing %d messages", len(sqsEvent.Records)))
 
+	lambdaDeadline, hasLambdaDeadline := ctx.Deadline()
+
+	response := events.SQSEventResponse{}
+	var responseMutex sync.Mutex
+	reportFailure := func(messageID string) {
+		responseMutex.Lock()
+		defer responseMutex.Unlock()
+		response.BatchItemFailures = append(response.BatchItemFailures, events.SQSBatchItemFailure{
+			ItemIdentifier: messageID,
+		})
+	}
+
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+
 	for _, record := range sqsEvent.Records {
-		if err := processIntent(ctx, record.Body); err != nil {
-			logError(fmt.Sprintf("Failed to process intent: %v", err))
-			// Continue processing other messages
+		record := record
+
+		select {
+		case sem <- struct{}{}:
+		default:
+			// Pool saturated: fail fast so SQS redrives this message on a
+			// less busy invocation instead of starving it behind others.
+			logError(fmt.Sprintf("Worker pool saturated, deferring message %s to redelivery", record.MessageId))
+			reportFailure(record.MessageId)
+			continue
 		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			recordCtx, span := tracer.Start(extractTraceContext(ctx, record), "processIntent")
+			defer span.End()
+
+			intent, binaryMode, err := decodeIntent(record)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				logError(fmt.Sprintf("Failed to decode intent: %v", err))
+				reportFailure(record.MessageId)
+				return
+			}
+
+			intentCtx, cancel := boundedIntentContext(recordCtx, intent, lambdaDeadline, hasLambdaDeadline)
+			defer cancel()
+
+			if _, err := processIntent(intentCtx, intent, binaryMode); err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				logError(fmt.Sprintf("Failed to process intent: %v", err))
+				reportFailure(record.MessageId)
+			}
+		}()
 	}
 
-	return nil
+	wg.Wait()
+
+	return response, nil
 }
 
-func processIntent(ctx context.Context, body string) error {
-	// Parse intent
-	var intent Intent
-	if err := json.Unmarshal([]byte(body), &intent); err != nil {
-		return fmt.Errorf("failed to parse intent: %w", err)
+// decodeIntent extracts an Intent from an SQS record, preferring
+// CloudEvents (structured or binary mode) and falling back to the legacy
+// bespoke Intent schema. binaryMode reports which CloudEvents content mode
+// the intent arrived in, if any, so a result callback can mirror it.
+func decodeIntent(record events.SQSMessage) (intent Intent, binaryMode bool, err error) {
+	ce, isCloudEvent := decodeCloudEvent(record)
+	if isCloudEvent {
+		_, binaryMode = ceAttribute(record, "specversion")
+		intent, err = intentFromCloudEvent(ce)
+		return intent, binaryMode, err
 	}
 
+	if err := json.Unmarshal([]byte(record.Body), &intent); err != nil {
+		return Intent{}, false, fmt.Errorf("failed to parse intent: %w", err)
+	}
+
+	return intent, false, nil
+}
+
+// boundedIntentContext derives a per-intent context.WithDeadline from
+// Parameters["timeoutSeconds"] (falling back to defaultIntentTimeout),
+// clamped to the Lambda invocation's own deadline so a generous per-intent
+// timeout can never outlive the function itself.
+func boundedIntentContext(ctx context.Context, intent Intent, lambdaDeadline time.Time, hasLambdaDeadline bool) (context.Context, context.CancelFunc) {
+	timeout := defaultIntentTimeout
+	if raw, ok := intent.Parameters["timeoutSeconds"]; ok {
+		if seconds, ok := toFloat64(raw); ok && seconds > 0 {
+			timeout = time.Duration(seconds * float64(time.Second))
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+	if hasLambdaDeadline && lambdaDeadline.Before(deadline) {
+		deadline = lambdaDeadline
+	}
+
+	return context.WithDeadline(ctx, deadline)
+}
+
+// toFloat64 coerces the common numeric shapes a JSON-decoded
+// map[string]interface{} parameter can take.
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func processIntent(ctx context.Context, intent Intent, binaryMode bool) (*OperationResult, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("intent.id", intent.ID),
+		attribute.String("intent.operation", intent.Operation),
+		attribute.String("cloud.provider", intent.Cloud),
+	)
+
 	logInfo(fmt.Sprintf("Processing intent: %s - %s on %s", intent.ID, intent.Operation, intent.Cloud))
 
-	// Get cloud executor
-	executor, err := GetExecutor(intent.Cloud)
+	priorResult, alreadyProcessed, err := idempotencyStore.Begin(ctx, intent.ID)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency check failed: %w", err)
+	}
+	if alreadyProcessed {
+		logInfo(fmt.Sprintf("Intent %s already processed, short-circuiting redelivery", intent.ID))
+		return priorResult, nil
+	}
+
+	result, err := executeIntent(ctx, intent)
+	if err != nil {
+		if failErr := idempotencyStore.Fail(ctx, intent.ID); failErr != nil {
+			// Not fatal to this attempt, but a redelivery within the stale
+			// window will short-circuit against the dangling in_progress
+			// lock instead of retrying, so surface it loudly.
+			logError(fmt.Sprintf("Failed to record idempotency failure for %s: %v", intent.ID, failErr))
+		}
+		if deadLetterPublisher != nil {
+			if dlqErr := deadLetterPublisher.Publish(ctx, intent, err); dlqErr != nil {
+				logError(fmt.Sprintf("Failed to publish intent %s to dead-letter target: %v", intent.ID, dlqErr))
+			}
+		}
+		return nil, err
+	}
+
+	if err := idempotencyStore.Complete(ctx, intent.ID, result); err != nil {
+		// The operation itself succeeded; a failure to record that shouldn't
+		// fail the message, it just risks a harmless re-execution on redelivery.
+		logError(fmt.Sprintf("Failed to record idempotency completion for %s: %v", intent.ID, err))
+	}
+
+	if intent.CallbackURL != "" {
+		resultCE, err := resultCloudEvent(intent, result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build result CloudEvent: %w", err)
+		}
+		if err := sendResultCloudEvent(ctx, intent.CallbackURL, resultCE, binaryMode); err != nil {
+			return nil, fmt.Errorf("failed to send callback: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// executeIntent dispatches intent to its cloud executor and runs it.
+func executeIntent(ctx context.Context, intent Intent) (*OperationResult, error) {
+	providerAttr := metric.WithAttributes(attribute.String("cloud.provider", intent.Cloud))
+	start := time.Now()
+
+	executor, err := GetExecutor(ctx, intent.Cloud)
+	if err != nil {
+		providerErrors.Add(ctx, 1, providerAttr)
+		return nil, fmt.Errorf("failed to get executor: %w", err)
+	}
+
+	decision, err := policyEngine.Evaluate(ctx, intent)
 	if err != nil {
-		return fmt.Errorf("failed to get executor: %w", err)
+		return nil, fmt.Errorf("policy evaluation failed: %w", err)
+	}
+	if !decision.Allow {
+		return deniedResult(decision), nil
+	}
+	if decision.ApprovalRequired {
+		token, _ := intent.Parameters["approvalToken"].(string)
+		if token == "" || token != decision.ApprovalToken {
+			return deniedResult(decision), nil
+		}
+	}
+	// Merge the policy's parameter overrides over the intent's own, rather
+	// than replacing the map outright, so a policy that only rewrites one
+	// parameter (e.g. clamping instance-type into an allowed set) doesn't
+	// silently drop every other parameter the intent carried.
+	if decision.Parameters != nil {
+		if intent.Parameters == nil {
+			intent.Parameters = make(map[string]interface{}, len(decision.Parameters))
+		}
+		for key, value := range decision.Parameters {
+			intent.Parameters[key] = value
+		}
 	}
 
-	// Validate access
 	if err := executor.ValidateAccess(ctx); err != nil {
-		return fmt.Errorf("access validation failed: %w", err)
+		providerErrors.Add(ctx, 1, providerAttr)
+		return nil, fmt.Errorf("access validation failed: %w", err)
 	}
 
-	// Execute operation
 	result, err := executor.Execute(ctx, intent)
+	operationLatency.Record(ctx, float64(time.Since(start).Milliseconds()), metric.WithAttributes(
+		attribute.String("cloud.provider", intent.Cloud),
+		attribute.String("intent.operation", intent.Operation),
+	))
 	if err != nil {
-		return fmt.Errorf("execution failed: %w", err)
+		providerErrors.Add(ctx, 1, providerAttr)
+		return nil, fmt.Errorf("execution failed: %w", err)
 	}
 
 	logInfo(fmt.Sprintf("Execution completed: %s - %s", intent.ID, result.Status))
 
-	// In production, send result to callback URL
-	if intent.CallbackURL != "" {
-		// sendCallback(intent.CallbackURL, result)
-		logInfo(fmt.Sprintf("Result would be sent to: %s", intent.CallbackURL))
-	}
-
-	return nil
+	return result, nil
 }
 
 // ============================================================================
@@ -581,6 +2194,23 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvInt reads an integer env var, falling back to defaultValue if it's
+// unset or not a valid integer.
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		logError(fmt.Sprintf("Invalid integer for %s=%q, using default %d", key, value, defaultValue))
+		return defaultValue
+	}
+
+	return parsed
+}
+
 func logInfo(message string) {
 	logMessage("INFO", message)
 }
@@ -621,5 +2251,26 @@ func logMessage(level, message string) {
 // ============================================================================
 
 func main() {
+	shutdownTelemetry, err := initTelemetry(context.Background())
+	if err != nil {
+		logError(fmt.Sprintf("Failed to initialize telemetry: %v", err))
+		shutdownTelemetry = func(context.Context) error { return nil }
+	}
+
+	// Lambda sends SIGTERM to the runtime before freezing/shutting down the
+	// execution environment; that's our one chance to flush the tracer and
+	// meter providers so the last invocation's telemetry isn't lost.
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		logInfo("Received SIGTERM, flushing telemetry")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTelemetry(shutdownCtx); err != nil {
+			logError(fmt.Sprintf("Failed to flush telemetry: %v", err))
+		}
+	}()
+
 	lambda.Start(Handler)
 }
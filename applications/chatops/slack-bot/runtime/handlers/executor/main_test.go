@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// inMemorySecretProvider is a test-only SecretProvider backed by a map, so
+// SecretCache can be fed provider results without talking to a real
+// backend.
+type inMemorySecretProvider struct {
+	values     map[string]string
+	fetchCount int
+}
+
+func (p *inMemorySecretProvider) Fetch(ctx context.Context, key string) (string, string, error) {
+	p.fetchCount++
+	value, ok := p.values[key]
+	if !ok {
+		return "", "", errSecretNotFound
+	}
+	return value, "1", nil
+}
+
+func TestParseSecretKey(t *testing.T) {
+	cases := []struct {
+		raw        string
+		wantScheme string
+		wantKey    string
+	}{
+		{"/slack-bot/prod/gcp-credentials", secretSchemeSSM, "/slack-bot/prod/gcp-credentials"},
+		{"ssm:///slack-bot/prod/gcp-credentials", secretSchemeSSM, "/slack-bot/prod/gcp-credentials"},
+		{"asm://my-secret", secretSchemeSecretsManager, "my-secret"},
+		{"akv://my-vault/my-secret", secretSchemeKeyVault, "my-vault/my-secret"},
+	}
+
+	for _, tc := range cases {
+		scheme, key := parseSecretKey(tc.raw)
+		if scheme != tc.wantScheme || key != tc.wantKey {
+			t.Errorf("parseSecretKey(%q) = (%q, %q), want (%q, %q)", tc.raw, scheme, key, tc.wantScheme, tc.wantKey)
+		}
+	}
+}
+
+func TestResolveSecretProviderUnknownScheme(t *testing.T) {
+	if _, err := resolveSecretProvider("nope"); err == nil {
+		t.Fatal("expected an error for an unregistered scheme")
+	}
+}
+
+func TestSecretCacheGetSetRoundTrip(t *testing.T) {
+	provider := &inMemorySecretProvider{values: map[string]string{"k": "v"}}
+
+	cache := NewSecretCache(time.Minute)
+	if _, found := cache.Get("k"); found {
+		t.Fatal("expected a miss on an empty cache")
+	}
+
+	value, _, err := provider.Fetch(context.Background(), "k")
+	if err != nil {
+		t.Fatalf("unexpected fetch error: %v", err)
+	}
+	cache.setPositive("k", value, "1")
+
+	got, found := cache.Get("k")
+	if !found || got != "v" {
+		t.Fatalf("Get(\"k\") = (%q, %v), want (\"v\", true)", got, found)
+	}
+}
+
+func TestSecretCacheNegativeCaching(t *testing.T) {
+	provider := &inMemorySecretProvider{values: map[string]string{}}
+	cache := NewSecretCache(time.Minute)
+
+	_, _, err := provider.Fetch(context.Background(), "missing")
+	if !errors.Is(err, errSecretNotFound) {
+		t.Fatalf("expected errSecretNotFound, got %v", err)
+	}
+	cache.setNegative("missing")
+
+	if !cache.isNegativelyCached("missing") {
+		t.Fatal("expected \"missing\" to be negatively cached")
+	}
+	if _, found := cache.Get("missing"); found {
+		t.Fatal("a negatively cached key must not be returned as a found value")
+	}
+}
+
+func TestSecretCacheExpiration(t *testing.T) {
+	cache := NewSecretCache(time.Millisecond)
+	cache.setPositive("k", "v", "1")
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, found := cache.Get("k"); found {
+		t.Fatal("expected the cached value to have expired")
+	}
+}
+
+func TestSecretCacheTTLOverride(t *testing.T) {
+	cache := NewSecretCache(time.Hour)
+	cache.SetTTLOverride("short-lived", time.Millisecond)
+
+	cache.setPositive("short-lived", "v", "1")
+	cache.setPositive("long-lived", "v", "1")
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, found := cache.Get("short-lived"); found {
+		t.Fatal("expected the TTL-overridden key to have expired")
+	}
+	if _, found := cache.Get("long-lived"); !found {
+		t.Fatal("expected the key without an override to still use the cache default TTL")
+	}
+}